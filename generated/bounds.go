@@ -0,0 +1,157 @@
+package fid
+
+import (
+	"fmt"
+	"math"
+)
+
+// BoundsRange is the numeric range a FID's scalar value is expected to
+// fall within: [Min, Max] inclusive, unless MaxExclusive is set, in which
+// case the range is [Min, Max) — e.g. heading and wind direction, which
+// wrap back to 0 at 360 rather than reaching it.
+type BoundsRange struct {
+	Min          float64
+	Max          float64
+	MaxExclusive bool
+}
+
+// exclusiveMaxFids holds the FIDs whose declared upper bound is reached
+// but never attained (the value wraps back to the range's Min instead),
+// so ValidateValue must reject Max itself for these.
+var exclusiveMaxFids = map[Fid]bool{
+	FidHeading:       true,
+	FidWindDirection: true,
+}
+
+// Bounds returns the semantic range of f, derived from Specs[f].Min/Max.
+// It reports false if f has no declared bound in either direction.
+func Bounds(f Fid) (BoundsRange, bool) {
+	spec, ok := Specs[f]
+	if !ok {
+		return BoundsRange{}, false
+	}
+	return boundsFromSpec(f, spec)
+}
+
+// boundsFromSpec is the shared implementation behind Bounds and
+// Registry.ValidateValue: both know which FidSpec applies and just need
+// its Min/Max turned into a BoundsRange.
+func boundsFromSpec(f Fid, spec FidSpec) (BoundsRange, bool) {
+	if spec.Min == nil && spec.Max == nil {
+		return BoundsRange{}, false
+	}
+
+	b := BoundsRange{Min: math.Inf(-1), Max: math.Inf(1), MaxExclusive: exclusiveMaxFids[f]}
+	if spec.Min != nil {
+		b.Min = *spec.Min
+	}
+	if spec.Max != nil {
+		b.Max = *spec.Max
+	}
+	return b, true
+}
+
+// arity holds the exact element count expected for FIDs that carry a
+// fixed-length numeric tuple (e.g. a 3-vector position), rather than a
+// variable-length list.
+var arity = map[Fid]int{
+	FidPosition:           3,
+	FidRotation:           3,
+	FidVelocity:           3,
+	FidAcceleration:       3,
+	FidQuaternion:         4,
+	FidBoundingBox:        6,
+	FidAngularVelocity:    3,
+	FidLinearAcceleration: 3,
+	FidMagneticField:      3,
+	FidOrientation:        4,
+	FidTwist:              6,
+}
+
+// ValidateValue checks that v is both the right wire type for f (see
+// Validate) and, for FIDs with a declared semantic range or fixed arity,
+// that it falls inside that range or has the expected element count. It
+// lets producers catch garbage before serialization and consumers reject
+// malformed frames early.
+func ValidateValue(f Fid, v any) error {
+	if err := Validate(f, v); err != nil {
+		return err
+	}
+
+	if n, ok := arity[f]; ok {
+		if got := sliceLen(v); got != n {
+			return fmt.Errorf("fid: fid %d expects %d elements, got %d", f, n, got)
+		}
+	}
+
+	spec := Specs[f]
+	return checkBounds(f, spec, v)
+}
+
+// checkBounds is the shared bounds check behind ValidateValue and
+// Registry.ValidateValue: both have already resolved which FidSpec
+// applies and just need v checked against it.
+func checkBounds(f Fid, spec FidSpec, v any) error {
+	b, ok := boundsFromSpec(f, spec)
+	if !ok {
+		return nil
+	}
+
+	x, ok := scalarFloat(v)
+	if !ok {
+		return nil
+	}
+
+	belowMin := x < b.Min
+	aboveMax := x > b.Max
+	atExclusiveMax := b.MaxExclusive && x == b.Max
+	if belowMin || aboveMax || atExclusiveMax {
+		if b.MaxExclusive {
+			return fmt.Errorf("fid: fid %d value %v out of bounds [%v, %v)", f, x, b.Min, b.Max)
+		}
+		return fmt.Errorf("fid: fid %d value %v out of bounds [%v, %v]", f, x, b.Min, b.Max)
+	}
+	return nil
+}
+
+func sliceLen(v any) int {
+	switch s := v.(type) {
+	case []float32:
+		return len(s)
+	case []float64:
+		return len(s)
+	case []int32:
+		return len(s)
+	case []int64:
+		return len(s)
+	case []uint32:
+		return len(s)
+	case []uint64:
+		return len(s)
+	case []string:
+		return len(s)
+	case []bool:
+		return len(s)
+	default:
+		return -1
+	}
+}
+
+func scalarFloat(v any) (float64, bool) {
+	switch x := v.(type) {
+	case float32:
+		return float64(x), true
+	case float64:
+		return x, true
+	case int32:
+		return float64(x), true
+	case int64:
+		return float64(x), true
+	case uint32:
+		return float64(x), true
+	case uint64:
+		return float64(x), true
+	default:
+		return 0, false
+	}
+}
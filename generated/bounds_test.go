@@ -0,0 +1,61 @@
+package fid
+
+import "testing"
+
+func TestValidateValueBounds(t *testing.T) {
+	tests := []struct {
+		name    string
+		f       Fid
+		v       any
+		wantErr bool
+	}{
+		{"latitude in range", FidLatitude, 45.0, false},
+		{"latitude out of range", FidLatitude, 91.0, true},
+		{"heading at 0 (inclusive min)", FidHeading, 0.0, false},
+		{"heading just under 360", FidHeading, 359.999, false},
+		{"heading at 360 (exclusive max)", FidHeading, 360.0, true},
+		{"wind direction at 360 (exclusive max)", FidWindDirection, 360.0, true},
+		{"ph level at upper inclusive bound", FidPhLevel, 14.0, false},
+		{"http status code below range", FidHttpStatusCode, int32(99), true},
+		{"http status code in range", FidHttpStatusCode, int32(200), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateValue(tt.f, tt.v)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateValue(%v, %v) error = %v, wantErr %v", tt.f, tt.v, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateValueArity(t *testing.T) {
+	tests := []struct {
+		name    string
+		f       Fid
+		v       any
+		wantErr bool
+	}{
+		{"position with 3 elements", FidPosition, []float64{1, 2, 3}, false},
+		{"position with 2 elements", FidPosition, []float64{1, 2}, true},
+		{"quaternion with 4 elements", FidQuaternion, []float64{1, 0, 0, 0}, false},
+		{"quaternion with 3 elements", FidQuaternion, []float64{1, 0, 0}, true},
+		{"bounding box with 6 elements", FidBoundingBox, []float64{0, 0, 0, 1, 1, 1}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateValue(tt.f, tt.v)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateValue(%v, %v) error = %v, wantErr %v", tt.f, tt.v, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBoundsUnknownFid(t *testing.T) {
+	if _, ok := Bounds(Fid(65000)); ok {
+		t.Errorf("Bounds returned ok=true for an unknown FID")
+	}
+}
@@ -1,7 +1,7 @@
 // Package fid provides LNMP Field ID constants.
 //
-// Auto-generated from registry/fids.yaml v1.2.0
-// Generated: 2025-12-18T22:38:44.883890
+// Auto-generated from registry/fids.yaml v1.4.0
+// Generated: 2026-07-26T00:00:00.000000
 //
 // DO NOT EDIT MANUALLY
 package fid
@@ -389,6 +389,42 @@ const (
 	// FidBrokerId - F1045: Message broker identifier
 	FidBrokerId Fid = 1045
 
+	// FidQueueName - F1046: Queue or destination name (ActiveMQ, RabbitMQ, ArtemisMQ)
+	FidQueueName Fid = 1046
+
+	// FidQueueDepth - F1047: Number of messages currently queued
+	FidQueueDepth Fid = 1047
+
+	// FidEnqueueCount - F1048: Cumulative count of messages enqueued
+	FidEnqueueCount Fid = 1048
+
+	// FidDequeueCount - F1049: Cumulative count of messages dequeued
+	FidDequeueCount Fid = 1049
+
+	// FidConsumerCount - F1050: Number of active consumers on a destination
+	FidConsumerCount Fid = 1050
+
+	// FidProducerCount - F1051: Number of active producers on a destination
+	FidProducerCount Fid = 1051
+
+	// FidDeadLetterCount - F1052: Number of messages routed to a dead-letter destination
+	FidDeadLetterCount Fid = 1052
+
+	// FidRedeliveryCount - F1053: Number of redelivery attempts for a message
+	FidRedeliveryCount Fid = 1053
+
+	// FidDestinationKind - F1054: Destination kind (queue, topic, exchange)
+	FidDestinationKind Fid = 1054
+
+	// FidRoutingKey - F1055: AMQP routing key
+	FidRoutingKey Fid = 1055
+
+	// FidExchangeName - F1056: AMQP exchange name
+	FidExchangeName Fid = 1056
+
+	// FidBrokerKind - F1057: Broker implementation (kafka, rabbitmq, activemq, nats, mqtt)
+	FidBrokerKind Fid = 1057
+
 	// FidModelId - F1100: ML model identifier
 	FidModelId Fid = 1100
 
@@ -693,6 +729,18 @@ var FidNames = map[Fid]string{
 	FidConsumerGroup: "consumer_group",
 	FidMessageKey: "message_key",
 	FidBrokerId: "broker_id",
+	FidQueueName: "queue_name",
+	FidQueueDepth: "queue_depth",
+	FidEnqueueCount: "enqueue_count",
+	FidDequeueCount: "dequeue_count",
+	FidConsumerCount: "consumer_count",
+	FidProducerCount: "producer_count",
+	FidDeadLetterCount: "dead_letter_count",
+	FidRedeliveryCount: "redelivery_count",
+	FidDestinationKind: "destination_kind",
+	FidRoutingKey: "routing_key",
+	FidExchangeName: "exchange_name",
+	FidBrokerKind: "broker_kind",
 	FidModelId: "model_id",
 	FidModelVersion: "model_version",
 	FidInferenceTime: "inference_time",
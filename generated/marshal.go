@@ -0,0 +1,87 @@
+package fid
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var fidByName = func() map[string]Fid {
+	m := make(map[string]Fid, len(FidNames))
+	for f, name := range FidNames {
+		m[name] = f
+	}
+	return m
+}()
+
+// ParseFid parses either a numeric form ("F284", "284") or a symbolic name
+// ("ground_speed") into a Fid, so config files and CLI flags can reference
+// FIDs either way. Names are looked up in FidNames only; names registered
+// in a Registry are not consulted. A name that happens to start with "F"
+// (e.g. "Fancy") still resolves by name: the "F"-prefixed numeric form
+// only wins when the suffix actually parses as digits.
+func ParseFid(s string) (Fid, error) {
+	if n, ok := strings.CutPrefix(s, "F"); ok {
+		if id, err := strconv.ParseUint(n, 10, 16); err == nil {
+			return Fid(id), nil
+		}
+	}
+	if id, err := strconv.ParseUint(s, 10, 16); err == nil {
+		return Fid(id), nil
+	}
+	if f, ok := fidByName[s]; ok {
+		return f, nil
+	}
+	return 0, fmt.Errorf("fid: unknown FID name %q", s)
+}
+
+// MarshalJSON renders f as its symbolic name when known, or as a plain
+// JSON number when f is not in FidNames, so a binary that doesn't yet know
+// about a newly-allocated FID can still round-trip it.
+func (f Fid) MarshalJSON() ([]byte, error) {
+	if name, ok := FidNames[f]; ok {
+		return json.Marshal(name)
+	}
+	return json.Marshal(uint16(f))
+}
+
+// UnmarshalJSON accepts either a symbolic name or a numeric FID, in either
+// string ("284", "F284") or JSON-number form.
+func (f *Fid) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		parsed, err := ParseFid(name)
+		if err != nil {
+			return err
+		}
+		*f = parsed
+		return nil
+	}
+
+	var id uint16
+	if err := json.Unmarshal(data, &id); err != nil {
+		return fmt.Errorf("fid: invalid FID %s: %w", data, err)
+	}
+	*f = Fid(id)
+	return nil
+}
+
+// MarshalText renders f the same way as MarshalJSON, for use with
+// text-based encodings (YAML, env vars, flag values).
+func (f Fid) MarshalText() ([]byte, error) {
+	if name, ok := FidNames[f]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(f), 10)), nil
+}
+
+// UnmarshalText accepts the same forms as ParseFid.
+func (f *Fid) UnmarshalText(text []byte) error {
+	parsed, err := ParseFid(string(text))
+	if err != nil {
+		return err
+	}
+	*f = parsed
+	return nil
+}
@@ -0,0 +1,124 @@
+package fid
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFidString(t *testing.T) {
+	if got := FidLatitude.String(); got != FidNames[FidLatitude] {
+		t.Errorf("FidLatitude.String() = %q, want %q", got, FidNames[FidLatitude])
+	}
+	if got, want := Fid(65000).String(), "Fid(65000)"; got != want {
+		t.Errorf("Fid(65000).String() = %q, want %q", got, want)
+	}
+}
+
+// TestFidNamesMatchString pins String() against FidNames for every known
+// FID, so a change to Fid.String that stops returning the FidNames entry
+// is caught here (String isn't produced by a generator; see the comment on
+// Fid.String in stringer.go).
+func TestFidNamesMatchString(t *testing.T) {
+	for f, name := range FidNames {
+		if got := f.String(); got != name {
+			t.Errorf("Fid(%d).String() = %q, want %q (FidNames)", uint16(f), got, name)
+		}
+	}
+}
+
+func TestParseFid(t *testing.T) {
+	if got, err := ParseFid(FidNames[FidLatitude]); err != nil || got != FidLatitude {
+		t.Errorf("ParseFid(%q) = %v, %v, want %v, nil", FidNames[FidLatitude], got, err, FidLatitude)
+	}
+	if got, err := ParseFid("284"); err != nil || got != Fid(284) {
+		t.Errorf(`ParseFid("284") = %v, %v, want 284, nil`, got, err)
+	}
+	if got, err := ParseFid("F284"); err != nil || got != Fid(284) {
+		t.Errorf(`ParseFid("F284") = %v, %v, want 284, nil`, got, err)
+	}
+	if _, err := ParseFid("not_a_fid"); err == nil {
+		t.Error(`ParseFid("not_a_fid") = nil error, want error`)
+	}
+}
+
+// TestParseFidNameStartingWithF guards against ParseFid treating every
+// "F"-prefixed string as numeric: a name that happens to start with
+// capital "F" must still resolve by name lookup. fidByName is built once
+// from FidNames at package init, so this test pokes a synthetic entry
+// into it directly rather than trying to extend the compiled-in registry.
+func TestParseFidNameStartingWithF(t *testing.T) {
+	const name = "Fancy"
+	f := FidPrivateStart + 1
+	fidByName[name] = f
+	defer delete(fidByName, name)
+
+	got, err := ParseFid(name)
+	if err != nil {
+		t.Fatalf("ParseFid(%q) returned error: %v", name, err)
+	}
+	if got != f {
+		t.Errorf("ParseFid(%q) = %v, want %v", name, got, f)
+	}
+}
+
+func TestFidMarshalUnmarshalJSON(t *testing.T) {
+	data, err := json.Marshal(FidLatitude)
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+	if want := `"` + FidNames[FidLatitude] + `"`; string(data) != want {
+		t.Errorf("MarshalJSON(FidLatitude) = %s, want %s", data, want)
+	}
+
+	var got Fid
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+	if got != FidLatitude {
+		t.Errorf("UnmarshalJSON(%s) = %v, want %v", data, got, FidLatitude)
+	}
+
+	unknown := Fid(65000)
+	data, err = json.Marshal(unknown)
+	if err != nil {
+		t.Fatalf("MarshalJSON(unknown): %v", err)
+	}
+	if string(data) != "65000" {
+		t.Errorf("MarshalJSON(unknown) = %s, want 65000", data)
+	}
+	var got2 Fid
+	if err := json.Unmarshal(data, &got2); err != nil || got2 != unknown {
+		t.Errorf("UnmarshalJSON(65000) = %v, %v, want %v, nil", got2, err, unknown)
+	}
+
+	if err := json.Unmarshal([]byte(`"not_a_fid"`), &got); err == nil {
+		t.Error(`UnmarshalJSON("not_a_fid") = nil error, want error`)
+	}
+}
+
+func TestFidMarshalUnmarshalText(t *testing.T) {
+	text, err := FidLatitude.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText: %v", err)
+	}
+	if string(text) != FidNames[FidLatitude] {
+		t.Errorf("MarshalText(FidLatitude) = %s, want %s", text, FidNames[FidLatitude])
+	}
+
+	var got Fid
+	if err := got.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText: %v", err)
+	}
+	if got != FidLatitude {
+		t.Errorf("UnmarshalText(%s) = %v, want %v", text, got, FidLatitude)
+	}
+
+	unknown := Fid(65000)
+	text, err = unknown.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText(unknown): %v", err)
+	}
+	if string(text) != "65000" {
+		t.Errorf("MarshalText(unknown) = %s, want 65000", text)
+	}
+}
@@ -0,0 +1,75 @@
+package fid
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// FidPrivateStart and FidPrivateEnd bound the range reserved for
+// third-party/vendor extension. FIDs in [FidPrivateStart, FidPrivateEnd]
+// are never allocated by the official registry, so vendors can mint their
+// own without coordinating with upstream.
+const (
+	FidPrivateStart Fid = 32768
+	FidPrivateEnd   Fid = 65535
+
+	privateVendorBuckets = 8
+	privateLocalIDBits   = 12
+	privateLocalIDMax    = 1<<privateLocalIDBits - 1
+)
+
+// IsPrivate reports whether f falls in the reserved vendor/private range.
+func IsPrivate(f Fid) bool {
+	return f >= FidPrivateStart && f <= FidPrivateEnd
+}
+
+// IsOfficial reports whether f is outside the reserved vendor/private
+// range, i.e. it is (or could be) an IANA-like FID allocated by the
+// official registry.
+func IsOfficial(f Fid) bool {
+	return !IsPrivate(f)
+}
+
+// AllocatePrivate deterministically maps a (vendor, localID) pair into the
+// private FID range and registers it under a vendor-qualified name. The
+// top bit of the resulting FID is the private-range bit, the next 3 bits
+// are a stable hash bucket of vendor (one of privateVendorBuckets), and
+// the low 12 bits are localID, so two vendors picking the same localID
+// land in different FIDs as long as their names don't collide in the same
+// bucket, and a single vendor always gets the same FID back for the same
+// localID.
+func (r *Registry) AllocatePrivate(vendor string, localID uint16) (Fid, error) {
+	if vendor == "" {
+		return 0, fmt.Errorf("fid: vendor must not be empty")
+	}
+	if localID > privateLocalIDMax {
+		return 0, fmt.Errorf("fid: private local id %d does not fit in %d bits", localID, privateLocalIDBits)
+	}
+
+	bucket := vendorBucket(vendor)
+	f := FidPrivateStart | Fid(bucket<<privateLocalIDBits) | Fid(localID)
+
+	name := fmt.Sprintf("vendor.%s.%d", vendor, localID)
+	if err := r.Register(f, name); err != nil {
+		return 0, err
+	}
+	return f, nil
+}
+
+// WalkPrivate calls fn for every private FID currently registered, so
+// tooling can distinguish vendor extensions from official FIDs in logs and
+// schema exports.
+func (r *Registry) WalkPrivate(fn func(Fid, string)) {
+	for f, name := range r.names {
+		if IsPrivate(f) {
+			fn(f, name)
+		}
+	}
+}
+
+// vendorBucket hashes vendor into a stable [0, privateVendorBuckets) value.
+func vendorBucket(vendor string) uint16 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(vendor))
+	return uint16(h.Sum32() % privateVendorBuckets)
+}
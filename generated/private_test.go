@@ -0,0 +1,98 @@
+package fid
+
+import "testing"
+
+func TestIsPrivateIsOfficial(t *testing.T) {
+	tests := []struct {
+		f    Fid
+		want bool
+	}{
+		{FidEntityId, false},
+		{FidNamespaceIndex, false},
+		{FidPrivateStart, true},
+		{FidPrivateEnd, true},
+		{FidPrivateStart + 1, true},
+	}
+	for _, tt := range tests {
+		if got := IsPrivate(tt.f); got != tt.want {
+			t.Errorf("IsPrivate(%v) = %v, want %v", tt.f, got, tt.want)
+		}
+		if got := IsOfficial(tt.f); got != !tt.want {
+			t.Errorf("IsOfficial(%v) = %v, want %v", tt.f, got, !tt.want)
+		}
+	}
+}
+
+func TestAllocatePrivateIsDeterministic(t *testing.T) {
+	r := NewRegistry()
+
+	f1, err := r.AllocatePrivate("acme", 7)
+	if err != nil {
+		t.Fatalf("AllocatePrivate: %v", err)
+	}
+	if !IsPrivate(f1) {
+		t.Errorf("AllocatePrivate returned %v, which is not in the private range", f1)
+	}
+
+	r2 := NewRegistry()
+	f2, err := r2.AllocatePrivate("acme", 7)
+	if err != nil {
+		t.Fatalf("AllocatePrivate: %v", err)
+	}
+	if f1 != f2 {
+		t.Errorf("AllocatePrivate(\"acme\", 7) was not deterministic: %v != %v", f1, f2)
+	}
+}
+
+func TestAllocatePrivateDistinctLocalIDs(t *testing.T) {
+	r := NewRegistry()
+	f1, err := r.AllocatePrivate("acme", 1)
+	if err != nil {
+		t.Fatalf("AllocatePrivate: %v", err)
+	}
+	f2, err := r.AllocatePrivate("acme", 2)
+	if err != nil {
+		t.Fatalf("AllocatePrivate: %v", err)
+	}
+	if f1 == f2 {
+		t.Errorf("AllocatePrivate(\"acme\", 1) and (\"acme\", 2) collided at %v", f1)
+	}
+}
+
+func TestAllocatePrivateRejectsOutOfRangeLocalID(t *testing.T) {
+	r := NewRegistry()
+	if _, err := r.AllocatePrivate("acme", 4096); err == nil {
+		t.Error("AllocatePrivate accepted a local ID that doesn't fit in 12 bits")
+	}
+}
+
+func TestAllocatePrivateRejectsEmptyVendor(t *testing.T) {
+	r := NewRegistry()
+	if _, err := r.AllocatePrivate("", 1); err == nil {
+		t.Error("AllocatePrivate accepted an empty vendor")
+	}
+}
+
+func TestWalkPrivate(t *testing.T) {
+	r := NewRegistry()
+	if err := r.Register(FidEntityId, "entity_id"); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	if _, err := r.AllocatePrivate("acme", 1); err != nil {
+		t.Fatalf("AllocatePrivate: %v", err)
+	}
+
+	seen := map[Fid]string{}
+	r.WalkPrivate(func(f Fid, name string) {
+		seen[f] = name
+	})
+
+	if len(seen) != 1 {
+		t.Fatalf("WalkPrivate visited %d FIDs, want 1 (official FidEntityId should be skipped)", len(seen))
+	}
+	for f := range seen {
+		if !IsPrivate(f) {
+			t.Errorf("WalkPrivate visited non-private FID %v", f)
+		}
+	}
+}
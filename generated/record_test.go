@@ -0,0 +1,30 @@
+package fid
+
+import "testing"
+
+// TestValidateRecordTypes guards against validateScalar silently rejecting
+// the non-repeated record-shaped FidTypes (FidTypeRecord, FidTypeRecordList):
+// FidNestedData and FidWaypoints/FidRecordList model real FIDs with these
+// types and Repeated: false, so they go through validateScalar rather than
+// validateRepeated.
+func TestValidateRecordTypes(t *testing.T) {
+	if err := Validate(FidNestedData, map[string]any{"a": 1}); err != nil {
+		t.Errorf("Validate(FidNestedData, map[string]any{...}) = %v, want nil", err)
+	}
+	if err := Validate(FidNestedData, "not a record"); err == nil {
+		t.Error("Validate(FidNestedData, string) = nil, want error")
+	}
+
+	for _, f := range []Fid{FidRecordList, FidWaypoints} {
+		if err := Validate(f, []map[string]any{{"lat": 1.0, "lon": 2.0}}); err != nil {
+			t.Errorf("Validate(%v, []map[string]any{...}) = %v, want nil", f, err)
+		}
+		if err := Validate(f, map[string]any{"lat": 1.0}); err == nil {
+			t.Errorf("Validate(%v, map[string]any{...}) = nil, want error (expects a list of records)", f)
+		}
+	}
+
+	if err := ValidateValue(FidWaypoints, []map[string]any{{"lat": 1.0}}); err != nil {
+		t.Errorf("ValidateValue(FidWaypoints, ...) = %v, want nil", err)
+	}
+}
@@ -0,0 +1,197 @@
+package fid
+
+import (
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// yamlFid mirrors one `fids:` entry in registry/fids.yaml.
+type yamlFid struct {
+	ID       Fid      `yaml:"id"`
+	Name     string   `yaml:"name"`
+	Doc      string   `yaml:"doc"`
+	Type     string   `yaml:"type"`
+	Repeated bool     `yaml:"repeated"`
+	Unit     string   `yaml:"unit"`
+	Min      *float64 `yaml:"min"`
+	Max      *float64 `yaml:"max"`
+}
+
+// yamlTypes maps the registry/fids.yaml `type:` strings to FidType.
+var yamlTypes = map[string]FidType{
+	"bool":         FidTypeBool,
+	"i32":          FidTypeI32,
+	"i64":          FidTypeI64,
+	"u32":          FidTypeU32,
+	"u64":          FidTypeU64,
+	"f32":          FidTypeF32,
+	"f64":          FidTypeF64,
+	"string":       FidTypeString,
+	"bytes":        FidTypeBytes,
+	"record":       FidTypeRecord,
+	"list<record>": FidTypeRecordList,
+}
+
+// yamlRegistry mirrors the top-level shape of registry/fids.yaml.
+type yamlRegistry struct {
+	SchemaVersion string    `yaml:"schema_version"`
+	Fids          []yamlFid `yaml:"fids"`
+}
+
+// Registry is a mutable, runtime-loadable view of the FID namespace.
+//
+// Unlike the compile-time FidNames map, a Registry can be hydrated from a
+// fids.yaml at startup and extended with custom or vendor FIDs without a
+// rebuild. The zero value is not usable; construct one with NewRegistry.
+type Registry struct {
+	schemaVersion string
+	names         map[Fid]string
+	ids           map[string]Fid
+	specs         map[Fid]FidSpec
+}
+
+// NewRegistry returns an empty Registry containing none of the built-in
+// FIDs. Callers that want the official registry as a starting point should
+// use NewRegistryFromYAML with registry/fids.yaml, or seed one from
+// FidNames.
+func NewRegistry() *Registry {
+	return &Registry{
+		names: make(map[Fid]string),
+		ids:   make(map[string]Fid),
+		specs: make(map[Fid]FidSpec),
+	}
+}
+
+// NewRegistryFromYAML parses a schema-versioned fids.yaml document (see
+// registry/fids.yaml) and returns the resulting Registry. Entries that
+// carry wire-type metadata (`type`, `unit`, `min`, `max`) are loaded into
+// the registry's own FidSpec table, so a FID hot-loaded at runtime can be
+// checked with Registry.Validate/ValidateValue, not just Name/Lookup.
+func NewRegistryFromYAML(r io.Reader) (*Registry, error) {
+	var doc yamlRegistry
+	if err := yaml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("fid: decode registry yaml: %w", err)
+	}
+
+	reg := NewRegistry()
+	reg.schemaVersion = doc.SchemaVersion
+	for _, entry := range doc.Fids {
+		if err := reg.Register(entry.ID, entry.Name); err != nil {
+			return nil, fmt.Errorf("fid: loading fid %d: %w", entry.ID, err)
+		}
+		if entry.Type == "" {
+			continue
+		}
+		t, ok := yamlTypes[entry.Type]
+		if !ok {
+			return nil, fmt.Errorf("fid: loading fid %d: unknown type %q", entry.ID, entry.Type)
+		}
+		reg.specs[entry.ID] = FidSpec{
+			Type:     t,
+			Repeated: entry.Repeated,
+			Unit:     entry.Unit,
+			Min:      entry.Min,
+			Max:      entry.Max,
+			Doc:      entry.Doc,
+		}
+	}
+	return reg, nil
+}
+
+// SchemaVersion reports the schema_version of the fids.yaml this Registry
+// was loaded from, or "" if it was not loaded from YAML.
+func (r *Registry) SchemaVersion() string {
+	return r.schemaVersion
+}
+
+// Register adds a FID/name pair to the registry. It fails if the FID number
+// or the name is already registered under a different name/number, so a
+// vendor cannot silently shadow an existing allocation.
+func (r *Registry) Register(f Fid, name string) error {
+	if existing, ok := r.names[f]; ok && existing != name {
+		return fmt.Errorf("fid: %d already registered as %q", f, existing)
+	}
+	if existing, ok := r.ids[name]; ok && existing != f {
+		return fmt.Errorf("fid: name %q already registered as %d", name, existing)
+	}
+	r.names[f] = name
+	r.ids[name] = f
+	return nil
+}
+
+// Name returns the registered name for f, if any.
+func (r *Registry) Name(f Fid) (string, bool) {
+	name, ok := r.names[f]
+	return name, ok
+}
+
+// Lookup returns the FID registered under name, if any.
+func (r *Registry) Lookup(name string) (Fid, bool) {
+	f, ok := r.ids[name]
+	return f, ok
+}
+
+// Spec returns the FidSpec for f, preferring one hot-loaded into this
+// registry over the compiled-in Specs map, so a FID that was added or
+// overridden at runtime validates against its own metadata.
+func (r *Registry) Spec(f Fid) (FidSpec, bool) {
+	if s, ok := r.specs[f]; ok {
+		return s, true
+	}
+	s, ok := Specs[f]
+	return s, ok
+}
+
+// Validate is the registry-aware counterpart to the package-level
+// Validate: it checks v against this registry's Spec(f) instead of only
+// the compiled-in Specs map.
+func (r *Registry) Validate(f Fid, v any) error {
+	spec, ok := r.Spec(f)
+	if !ok {
+		return fmt.Errorf("fid: %d is not a known FID", f)
+	}
+	return validateSpec(f, spec, v)
+}
+
+// ValidateValue is the registry-aware counterpart to the package-level
+// ValidateValue: it checks v's wire type via Validate and, when this
+// registry's Spec(f) declares a semantic bound, that v falls within it.
+func (r *Registry) ValidateValue(f Fid, v any) error {
+	if err := r.Validate(f, v); err != nil {
+		return err
+	}
+
+	if n, ok := arity[f]; ok {
+		if got := sliceLen(v); got != n {
+			return fmt.Errorf("fid: fid %d expects %d elements, got %d", f, n, got)
+		}
+	}
+
+	spec, _ := r.Spec(f)
+	return checkBounds(f, spec, v)
+}
+
+// Merge copies every FID/name pair from other into r, failing without
+// modifying r if any pair collides with an existing entry under a
+// different name or number. This lets operators safely combine the
+// official registry with in-house extensions.
+func (r *Registry) Merge(other *Registry) error {
+	for f, name := range other.names {
+		if existing, ok := r.names[f]; ok && existing != name {
+			return fmt.Errorf("fid: merge conflict: %d is %q locally, %q in other", f, existing, name)
+		}
+		if existing, ok := r.ids[name]; ok && existing != f {
+			return fmt.Errorf("fid: merge conflict: name %q is %d locally, %d in other", name, existing, f)
+		}
+	}
+	for f, name := range other.names {
+		r.names[f] = name
+		r.ids[name] = f
+	}
+	for f, spec := range other.specs {
+		r.specs[f] = spec
+	}
+	return nil
+}
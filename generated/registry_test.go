@@ -0,0 +1,80 @@
+package fid
+
+import "testing"
+
+func TestRegistryRegisterAndLookup(t *testing.T) {
+	r := NewRegistry()
+
+	if err := r.Register(FidEntityId, "entity_id"); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	if name, ok := r.Name(FidEntityId); !ok || name != "entity_id" {
+		t.Errorf("Name(FidEntityId) = %q, %v, want \"entity_id\", true", name, ok)
+	}
+	if f, ok := r.Lookup("entity_id"); !ok || f != FidEntityId {
+		t.Errorf("Lookup(\"entity_id\") = %v, %v, want %v, true", f, ok, FidEntityId)
+	}
+	if _, ok := r.Lookup("no_such_fid"); ok {
+		t.Errorf("Lookup(\"no_such_fid\") reported ok=true")
+	}
+
+	// Re-registering the same FID under the same name is a no-op, not a
+	// collision.
+	if err := r.Register(FidEntityId, "entity_id"); err != nil {
+		t.Errorf("re-registering identical FID/name pair failed: %v", err)
+	}
+}
+
+func TestRegistryRegisterCollisions(t *testing.T) {
+	r := NewRegistry()
+	if err := r.Register(FidEntityId, "entity_id"); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	if err := r.Register(FidEntityId, "something_else"); err == nil {
+		t.Error("Register did not reject reusing a FID under a different name")
+	}
+	if err := r.Register(FidTimestamp, "entity_id"); err == nil {
+		t.Error("Register did not reject reusing a name under a different FID")
+	}
+}
+
+func TestRegistryMerge(t *testing.T) {
+	a := NewRegistry()
+	if err := a.Register(FidEntityId, "entity_id"); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	b := NewRegistry()
+	if err := b.Register(FidTimestamp, "timestamp"); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	if err := a.Merge(b); err != nil {
+		t.Fatalf("Merge: %v", err)
+	}
+	if _, ok := a.Lookup("timestamp"); !ok {
+		t.Error("Merge did not copy other's FIDs into the receiver")
+	}
+}
+
+func TestRegistryMergeCollision(t *testing.T) {
+	a := NewRegistry()
+	if err := a.Register(FidEntityId, "entity_id"); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	b := NewRegistry()
+	if err := b.Register(FidEntityId, "different_name"); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	if err := a.Merge(b); err == nil {
+		t.Error("Merge did not fail on a colliding FID")
+	}
+	// A failed merge must not partially apply.
+	if name, _ := a.Name(FidEntityId); name != "entity_id" {
+		t.Errorf("Merge partially modified the receiver: FidEntityId now %q", name)
+	}
+}
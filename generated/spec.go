@@ -0,0 +1,382 @@
+package fid
+
+import "fmt"
+
+// FidType is the wire-level primitive type carried by a FID's value.
+type FidType int
+
+// Primitive types implied by the FID registry. A value written under a
+// FID must decode to one of these (see Validate).
+const (
+	FidTypeBool FidType = iota
+	FidTypeI32
+	FidTypeI64
+	FidTypeU32
+	FidTypeU64
+	FidTypeF32
+	FidTypeF64
+	FidTypeString
+	FidTypeBytes
+	FidTypeRecord
+	FidTypeRecordList
+)
+
+// FidSpec describes the wire-type and cardinality of a single FID.
+type FidSpec struct {
+	Type     FidType
+	Repeated bool
+	Unit     string
+	Min      *float64
+	Max      *float64
+	Doc      string
+}
+
+func f64p(v float64) *float64 { return &v }
+
+// Specs maps each known FID to its FidSpec, populated alongside FidNames
+// by the code generator from registry/fids.yaml.
+var Specs = map[Fid]FidSpec{
+	FidEntityId: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Unique entity identifier within a context"},
+	FidTimestamp: {Type: FidTypeI64, Repeated: false, Unit: "ms", Min: nil, Max: nil, Doc: "Unix timestamp in milliseconds (UTC)"},
+	FidVersion: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Schema or data version number"},
+	FidSequence: {Type: FidTypeU64, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Monotonic sequence number for ordering"},
+	FidSource: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Origin identifier (service, device, node)"},
+	FidIsActive: {Type: FidTypeBool, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Active/inactive boolean flag"},
+	FidIsValid: {Type: FidTypeBool, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Validity boolean flag"},
+	FidUserId: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "User identifier"},
+	FidSessionId: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Session identifier"},
+	FidName: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Human-readable name"},
+	FidLabel: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Short label or tag"},
+	FidDescription: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Long-form description text"},
+	FidRoles: {Type: FidTypeString, Repeated: true, Unit: "", Min: nil, Max: nil, Doc: "List of role identifiers"},
+	FidTags: {Type: FidTypeString, Repeated: true, Unit: "", Min: nil, Max: nil, Doc: "List of tags for categorization"},
+	FidCount: {Type: FidTypeU64, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Generic count value"},
+	FidIndex: {Type: FidTypeU64, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Zero-based index"},
+	FidPriority: {Type: FidTypeU32, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Priority level (0 = lowest)"},
+	FidValue: {Type: FidTypeF64, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Generic floating-point value"},
+	FidScore: {Type: FidTypeF64, Repeated: false, Unit: "", Min: f64p(0), Max: f64p(1), Doc: "Score or rating (typically 0.0-1.0)"},
+	FidConfidence: {Type: FidTypeF64, Repeated: false, Unit: "", Min: f64p(0), Max: f64p(1), Doc: "Confidence level (0.0-1.0)"},
+	FidStatus: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Status string (pending, active, completed, etc.)"},
+	FidErrorCode: {Type: FidTypeI32, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Error code (0 = no error)"},
+	FidErrorMessage: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Human-readable error message"},
+	FidIntValues: {Type: FidTypeI64, Repeated: true, Unit: "", Min: nil, Max: nil, Doc: "Generic integer array for numeric data"},
+	FidFloatValues: {Type: FidTypeF64, Repeated: true, Unit: "", Min: nil, Max: nil, Doc: "Generic float array for measurement data"},
+	FidBoolFlags: {Type: FidTypeBool, Repeated: true, Unit: "", Min: nil, Max: nil, Doc: "Generic boolean array for flag sets"},
+	FidNestedData: {Type: FidTypeRecord, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Generic nested record container"},
+	FidRecordList: {Type: FidTypeRecordList, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Array of nested records"},
+	FidTraceId: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Distributed trace identifier (W3C Trace Context compatible)"},
+	FidSpanId: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Span identifier within a trace"},
+	FidParentSpanId: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Parent span identifier for trace hierarchy"},
+	FidTraceFlags: {Type: FidTypeU32, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Trace flags (sampled, random, etc.)"},
+	FidServiceName: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Originating service name (OpenTelemetry convention)"},
+	FidServiceVersion: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Originating service version"},
+	FidTimestampNs: {Type: FidTypeI64, Repeated: false, Unit: "ns", Min: nil, Max: nil, Doc: "Unix timestamp in nanoseconds (high-precision)"},
+	FidDurationMs: {Type: FidTypeI64, Repeated: false, Unit: "ms", Min: nil, Max: nil, Doc: "Duration/elapsed time in milliseconds"},
+	FidDurationNs: {Type: FidTypeI64, Repeated: false, Unit: "ns", Min: nil, Max: nil, Doc: "Duration/elapsed time in nanoseconds"},
+	FidStartTime: {Type: FidTypeI64, Repeated: false, Unit: "ms", Min: nil, Max: nil, Doc: "Start timestamp in milliseconds"},
+	FidEndTime: {Type: FidTypeI64, Repeated: false, Unit: "ms", Min: nil, Max: nil, Doc: "End timestamp in milliseconds"},
+	FidCreatedAt: {Type: FidTypeI64, Repeated: false, Unit: "ms", Min: nil, Max: nil, Doc: "Creation timestamp in milliseconds"},
+	FidUpdatedAt: {Type: FidTypeI64, Repeated: false, Unit: "ms", Min: nil, Max: nil, Doc: "Last update timestamp in milliseconds"},
+	FidEventType: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Event type classification (CloudEvents type)"},
+	FidEventSource: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Event origin URI (CloudEvents source)"},
+	FidCorrelationId: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Request correlation identifier for distributed systems"},
+	FidRequestId: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Unique request identifier"},
+	FidTransactionId: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Transaction identifier for business processes"},
+	FidTenantId: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Multi-tenancy identifier"},
+	FidOrganizationId: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Organization/workspace identifier"},
+	FidDeviceId: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Physical device identifier"},
+	FidStreamId: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Data stream identifier"},
+	FidChannelId: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Communication channel identifier"},
+	FidAuthToken: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Authentication token (JWT, OAuth, etc.)"},
+	FidRefreshToken: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Token refresh credential"},
+	FidTokenExpiry: {Type: FidTypeI64, Repeated: false, Unit: "ms", Min: nil, Max: nil, Doc: "Token expiration timestamp in milliseconds"},
+	FidScopes: {Type: FidTypeString, Repeated: true, Unit: "", Min: nil, Max: nil, Doc: "OAuth2 scopes or permission scopes"},
+	FidPermissions: {Type: FidTypeString, Repeated: true, Unit: "", Min: nil, Max: nil, Doc: "Access permission identifiers"},
+	FidApiKey: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "API key for service authentication"},
+	FidPosition: {Type: FidTypeF64, Repeated: true, Unit: "m", Min: nil, Max: nil, Doc: "[x, y, z] position coordinates in meters"},
+	FidRotation: {Type: FidTypeF64, Repeated: true, Unit: "rad", Min: nil, Max: nil, Doc: "[roll, pitch, yaw] Euler angles in radians"},
+	FidVelocity: {Type: FidTypeF64, Repeated: true, Unit: "m/s", Min: nil, Max: nil, Doc: "[vx, vy, vz] linear velocity"},
+	FidAcceleration: {Type: FidTypeF64, Repeated: true, Unit: "m/s^2", Min: nil, Max: nil, Doc: "[ax, ay, az] linear acceleration"},
+	FidQuaternion: {Type: FidTypeF64, Repeated: true, Unit: "", Min: nil, Max: nil, Doc: "[w, x, y, z] rotation quaternion"},
+	FidBoundingBox: {Type: FidTypeF64, Repeated: true, Unit: "m", Min: nil, Max: nil, Doc: "[min_x, min_y, min_z, max_x, max_y, max_z]"},
+	FidLatitude: {Type: FidTypeF64, Repeated: false, Unit: "deg", Min: f64p(-90), Max: f64p(90), Doc: "WGS84 latitude (-90 to 90 degrees)"},
+	FidLongitude: {Type: FidTypeF64, Repeated: false, Unit: "deg", Min: f64p(-180), Max: f64p(180), Doc: "WGS84 longitude (-180 to 180 degrees)"},
+	FidAltitude: {Type: FidTypeF64, Repeated: false, Unit: "m", Min: nil, Max: nil, Doc: "Altitude above sea level in meters"},
+	FidHeading: {Type: FidTypeF64, Repeated: false, Unit: "deg", Min: f64p(0), Max: f64p(360), Doc: "Compass heading (0-360 degrees, 0=North)"},
+	FidGroundSpeed: {Type: FidTypeF64, Repeated: false, Unit: "m/s", Min: nil, Max: nil, Doc: "Ground speed in meters per second"},
+	FidPositionAccuracy: {Type: FidTypeF64, Repeated: false, Unit: "m", Min: nil, Max: nil, Doc: "Position accuracy radius in meters"},
+	FidGeoHash: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "GeoHash encoded location string"},
+	FidUrl: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Full URL/URI"},
+	FidHostname: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Host name or IP address"},
+	FidPort: {Type: FidTypeU32, Repeated: false, Unit: "", Min: f64p(0), Max: f64p(65535), Doc: "Network port number (0-65535)"},
+	FidIpAddress: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "IPv4 or IPv6 address"},
+	FidHttpMethod: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "HTTP method (GET, POST, PUT, DELETE, etc.)"},
+	FidHttpStatusCode: {Type: FidTypeI32, Repeated: false, Unit: "", Min: f64p(100), Max: f64p(599), Doc: "HTTP response status code (200, 404, 500, etc.)"},
+	FidUserAgent: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "HTTP User-Agent header value"},
+	FidContentType: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "MIME content type (application/json, etc.)"},
+	FidContentLength: {Type: FidTypeU64, Repeated: false, Unit: "bytes", Min: nil, Max: nil, Doc: "Content size in bytes"},
+	FidEncoding: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Content encoding (utf-8, gzip, etc.)"},
+	FidEmbedding: {Type: FidTypeF32, Repeated: true, Unit: "", Min: nil, Max: nil, Doc: "Vector embedding (variable dimension)"},
+	FidEmbeddingModel: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Embedding model identifier"},
+	FidEmbeddingDim: {Type: FidTypeU32, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Embedding dimension size"},
+	FidAngularVelocity: {Type: FidTypeF32, Repeated: true, Unit: "rad/s", Min: nil, Max: nil, Doc: "[wx, wy, wz] angular velocity"},
+	FidLinearAcceleration: {Type: FidTypeF32, Repeated: true, Unit: "m/s^2", Min: nil, Max: nil, Doc: "[ax, ay, az] IMU linear acceleration"},
+	FidMagneticField: {Type: FidTypeF32, Repeated: true, Unit: "T", Min: nil, Max: nil, Doc: "[mx, my, mz] magnetic field in Tesla"},
+	FidOrientation: {Type: FidTypeF32, Repeated: true, Unit: "", Min: nil, Max: nil, Doc: "[w, x, y, z] orientation quaternion from IMU"},
+	FidJointPositions: {Type: FidTypeF32, Repeated: true, Unit: "rad", Min: nil, Max: nil, Doc: "Robot joint positions in radians"},
+	FidJointVelocities: {Type: FidTypeF32, Repeated: true, Unit: "", Min: nil, Max: nil, Doc: "Robot joint velocities"},
+	FidJointEfforts: {Type: FidTypeF32, Repeated: true, Unit: "", Min: nil, Max: nil, Doc: "Robot joint torques/forces"},
+	FidJointNames: {Type: FidTypeString, Repeated: true, Unit: "", Min: nil, Max: nil, Doc: "Robot joint names"},
+	FidWaypoints: {Type: FidTypeRecordList, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Navigation waypoints as records"},
+	FidPointCloud: {Type: FidTypeF32, Repeated: true, Unit: "m", Min: nil, Max: nil, Doc: "Flattened 3D point cloud [x,y,z,x,y,z,...]"},
+	FidTwist: {Type: FidTypeF64, Repeated: true, Unit: "", Min: nil, Max: nil, Doc: "[vx,vy,vz,wx,wy,wz] linear+angular velocity (ROS Twist)"},
+	FidTemperature: {Type: FidTypeF64, Repeated: false, Unit: "C", Min: nil, Max: nil, Doc: "Temperature in Celsius"},
+	FidHumidity: {Type: FidTypeF64, Repeated: false, Unit: "%", Min: f64p(0), Max: f64p(100), Doc: "Relative humidity percentage"},
+	FidPressure: {Type: FidTypeF64, Repeated: false, Unit: "Pa", Min: nil, Max: nil, Doc: "Pressure in Pascals"},
+	FidBatteryLevel: {Type: FidTypeF64, Repeated: false, Unit: "%", Min: f64p(0), Max: f64p(100), Doc: "Battery charge percentage"},
+	FidLuminosity: {Type: FidTypeF64, Repeated: false, Unit: "lux", Min: nil, Max: nil, Doc: "Light level in lux"},
+	FidNoiseLevel: {Type: FidTypeF64, Repeated: false, Unit: "dB", Min: nil, Max: nil, Doc: "Sound level in decibels"},
+	FidCo2Level: {Type: FidTypeF64, Repeated: false, Unit: "ppm", Min: nil, Max: nil, Doc: "CO2 concentration in parts per million"},
+	FidPm25: {Type: FidTypeF64, Repeated: false, Unit: "ug/m3", Min: nil, Max: nil, Doc: "PM2.5 particulate matter concentration"},
+	FidPm10: {Type: FidTypeF64, Repeated: false, Unit: "ug/m3", Min: nil, Max: nil, Doc: "PM10 particulate matter concentration"},
+	FidVoc: {Type: FidTypeF64, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Volatile organic compounds level"},
+	FidUvIndex: {Type: FidTypeF64, Repeated: false, Unit: "", Min: f64p(0), Max: nil, Doc: "UV radiation index (0-11+)"},
+	FidWindSpeed: {Type: FidTypeF64, Repeated: false, Unit: "m/s", Min: nil, Max: nil, Doc: "Wind speed in meters per second"},
+	FidWindDirection: {Type: FidTypeF64, Repeated: false, Unit: "deg", Min: f64p(0), Max: f64p(360), Doc: "Wind direction (0-360 degrees, 0=North)"},
+	FidRainfall: {Type: FidTypeF64, Repeated: false, Unit: "mm", Min: nil, Max: nil, Doc: "Rainfall accumulation in millimeters"},
+	FidSoilMoisture: {Type: FidTypeF64, Repeated: false, Unit: "%", Min: f64p(0), Max: f64p(100), Doc: "Soil moisture percentage"},
+	FidPhLevel: {Type: FidTypeF64, Repeated: false, Unit: "", Min: f64p(0), Max: f64p(14), Doc: "pH level (0-14 scale)"},
+	FidSignalStrength: {Type: FidTypeF64, Repeated: false, Unit: "dBm", Min: nil, Max: nil, Doc: "Signal strength (RSSI) in dBm"},
+	FidMessageKind: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Message classification (Event, State, Command, Query, Alert)"},
+	FidTtl: {Type: FidTypeU64, Repeated: false, Unit: "ms", Min: nil, Max: nil, Doc: "Time-to-live in milliseconds"},
+	FidQosPriority: {Type: FidTypeU32, Repeated: false, Unit: "", Min: f64p(0), Max: f64p(255), Doc: "QoS priority level (0-255)"},
+	FidRetryCount: {Type: FidTypeU32, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Number of delivery retries"},
+	FidDeliveryStatus: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Message delivery status (pending, delivered, failed)"},
+	FidAckRequired: {Type: FidTypeBool, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Whether acknowledgment is required"},
+	FidPayloadSchema: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Schema identifier for payload (CloudEvents dataschema)"},
+	FidCompression: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Compression algorithm (none, gzip, lz4, zstd)"},
+	FidEncryption: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Encryption algorithm (none, aes256, chacha20)"},
+	FidTopic: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Message queue topic name (Kafka, RabbitMQ, etc.)"},
+	FidPartitionId: {Type: FidTypeI32, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Kafka partition identifier"},
+	FidOffset: {Type: FidTypeI64, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Message offset within partition"},
+	FidConsumerGroup: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Consumer group identifier"},
+	FidMessageKey: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Message partitioning key"},
+	FidBrokerId: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Message broker identifier"},
+	FidQueueName: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Queue or destination name (ActiveMQ, RabbitMQ, ArtemisMQ)"},
+	FidQueueDepth: {Type: FidTypeU64, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Number of messages currently queued"},
+	FidEnqueueCount: {Type: FidTypeU64, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Cumulative count of messages enqueued"},
+	FidDequeueCount: {Type: FidTypeU64, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Cumulative count of messages dequeued"},
+	FidConsumerCount: {Type: FidTypeU32, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Number of active consumers on a destination"},
+	FidProducerCount: {Type: FidTypeU32, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Number of active producers on a destination"},
+	FidDeadLetterCount: {Type: FidTypeU64, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Number of messages routed to a dead-letter destination"},
+	FidRedeliveryCount: {Type: FidTypeU32, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Number of redelivery attempts for a message"},
+	FidDestinationKind: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Destination kind (queue, topic, exchange)"},
+	FidRoutingKey: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "AMQP routing key"},
+	FidExchangeName: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "AMQP exchange name"},
+	FidBrokerKind: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Broker implementation (kafka, rabbitmq, activemq, nats, mqtt)"},
+	FidModelId: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "ML model identifier"},
+	FidModelVersion: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "ML model version"},
+	FidInferenceTime: {Type: FidTypeF64, Repeated: false, Unit: "ms", Min: nil, Max: nil, Doc: "Model inference time in milliseconds"},
+	FidPredictions: {Type: FidTypeF64, Repeated: true, Unit: "", Min: nil, Max: nil, Doc: "Model prediction probabilities"},
+	FidPredictedClass: {Type: FidTypeI32, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Predicted class index"},
+	FidClassLabels: {Type: FidTypeString, Repeated: true, Unit: "", Min: nil, Max: nil, Doc: "Class label names"},
+	FidFeatures: {Type: FidTypeF64, Repeated: true, Unit: "", Min: nil, Max: nil, Doc: "Input feature vector"},
+	FidAttentionWeights: {Type: FidTypeF32, Repeated: true, Unit: "", Min: nil, Max: nil, Doc: "Attention/importance weights"},
+	FidTokenIds: {Type: FidTypeI64, Repeated: true, Unit: "", Min: nil, Max: nil, Doc: "Tokenized input IDs"},
+	FidInputTokens: {Type: FidTypeI64, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Input tokens for NLP"},
+	FidOutputTokens: {Type: FidTypeI64, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Output tokens for NLP"},
+	FidPrompt: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "LLM prompt text"},
+	FidCompletion: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "LLM completion text"},
+	FidLlmTemperature: {Type: FidTypeF64, Repeated: false, Unit: "", Min: f64p(0), Max: f64p(2), Doc: "LLM sampling temperature (0.0-2.0)"},
+	FidMaxTokens: {Type: FidTypeI64, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Maximum output tokens for LLM"},
+	FidResolutionWidth: {Type: FidTypeU32, Repeated: false, Unit: "px", Min: nil, Max: nil, Doc: "Video/image width in pixels"},
+	FidResolutionHeight: {Type: FidTypeU32, Repeated: false, Unit: "px", Min: nil, Max: nil, Doc: "Video/image height in pixels"},
+	FidFramerate: {Type: FidTypeF64, Repeated: false, Unit: "fps", Min: nil, Max: nil, Doc: "Video frame rate (frames per second)"},
+	FidBitrate: {Type: FidTypeU64, Repeated: false, Unit: "kbps", Min: nil, Max: nil, Doc: "Media bitrate in kilobits per second"},
+	FidCodec: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Media codec (H.264, H.265, VP9, AV1, etc.)"},
+	FidMediaDuration: {Type: FidTypeF64, Repeated: false, Unit: "s", Min: nil, Max: nil, Doc: "Media duration in seconds"},
+	FidSampleRate: {Type: FidTypeU32, Repeated: false, Unit: "Hz", Min: nil, Max: nil, Doc: "Audio sample rate in Hertz"},
+	FidAudioChannels: {Type: FidTypeU32, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Number of audio channels (1=mono, 2=stereo)"},
+	FidAspectRatio: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Video aspect ratio (16:9, 4:3, etc.)"},
+	FidBlockNumber: {Type: FidTypeU64, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Blockchain block number/height"},
+	FidBlockHash: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Blockchain block hash"},
+	FidTransactionHash: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Blockchain transaction hash"},
+	FidFromAddress: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Sender wallet address"},
+	FidToAddress: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Recipient wallet address"},
+	FidGasLimit: {Type: FidTypeU64, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Transaction gas limit"},
+	FidGasPrice: {Type: FidTypeString, Repeated: false, Unit: "wei", Min: nil, Max: nil, Doc: "Gas price in smallest unit (e.g., wei)"},
+	FidNonce: {Type: FidTypeU64, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Account nonce or transaction counter"},
+	FidCurrency: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "ISO 4217 currency code (usd, eur, jpy)"},
+	FidAmount: {Type: FidTypeI64, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Monetary amount in smallest unit (cents, yen)"},
+	FidTaxAmount: {Type: FidTypeI64, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Tax portion of the amount"},
+	FidDiscountAmount: {Type: FidTypeI64, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Discount portion of the amount"},
+	FidPaymentMethod: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Payment method identifier (card, bank_transfer)"},
+	FidTransactionStatus: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Transaction status (succeeded, pending, failed)"},
+	FidFileSize: {Type: FidTypeU64, Repeated: false, Unit: "bytes", Min: nil, Max: nil, Doc: "File size in bytes"},
+	FidMimeType: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "IANA media type (same as content_type)"},
+	FidFileExtension: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "File name extension (without dot)"},
+	FidChecksumMd5: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "MD5 checksum hex string"},
+	FidChecksumSha256: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "SHA-256 checksum hex string"},
+	FidLastModified: {Type: FidTypeI64, Repeated: false, Unit: "ms", Min: nil, Max: nil, Doc: "Last modification timestamp"},
+	FidPerformative: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Communicative act (request, inform, propose)"},
+	FidOntology: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Ontology name used in content"},
+	FidProtocol: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Interaction protocol (contract-net, auction)"},
+	FidLanguage: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Content language (fipa-sl, kilo, json)"},
+	FidReplyWith: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Identifier for reply correlation"},
+	FidInReplyTo: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Reference to original message identifier"},
+	FidFirmwareVersion: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Device firmware version string"},
+	FidSerialNumber: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Device serial number"},
+	FidDeviceModel: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Device model identifier"},
+	FidNodeId: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "OPC UA Node Identifier"},
+	FidBrowseName: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Non-localized browse name"},
+	FidDisplayName: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Localized display name"},
+	FidNodeClass: {Type: FidTypeString, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Node class (Object, Variable, Method)"},
+	FidNamespaceIndex: {Type: FidTypeU32, Repeated: false, Unit: "", Min: nil, Max: nil, Doc: "Namespace index for node identifier"},
+}
+
+// TypeOf returns the wire type of f, if f is a known FID.
+func TypeOf(f Fid) (FidType, bool) {
+	s, ok := Specs[f]
+	if !ok {
+		return 0, false
+	}
+	return s.Type, true
+}
+
+
+// Validate reports whether v is an acceptable Go representation of a value
+// written under FID f, based on Specs[f].Type and Repeated. It does not
+// check semantic bounds (e.g. latitude range); see ValidateValue for that.
+func Validate(f Fid, v any) error {
+	spec, ok := Specs[f]
+	if !ok {
+		return fmt.Errorf("fid: %d is not a known FID", f)
+	}
+	return validateSpec(f, spec, v)
+}
+
+// validateSpec is the shared implementation behind Validate and
+// Registry.Validate: both know which FidSpec applies (the compiled-in one
+// or a registry's hot-loaded override) and just need it checked against v.
+func validateSpec(f Fid, spec FidSpec, v any) error {
+	if spec.Repeated {
+		return validateRepeated(f, spec, v)
+	}
+	return validateScalar(f, spec.Type, v)
+}
+
+func validateScalar(f Fid, t FidType, v any) error {
+	ok := false
+	switch t {
+	case FidTypeBool:
+		_, ok = v.(bool)
+	case FidTypeI32:
+		_, ok = v.(int32)
+	case FidTypeI64:
+		_, ok = v.(int64)
+	case FidTypeU32:
+		_, ok = v.(uint32)
+	case FidTypeU64:
+		_, ok = v.(uint64)
+	case FidTypeF32:
+		_, ok = v.(float32)
+	case FidTypeF64:
+		_, ok = v.(float64)
+	case FidTypeString:
+		_, ok = v.(string)
+	case FidTypeBytes:
+		_, ok = v.([]byte)
+	case FidTypeRecord:
+		_, ok = v.(map[string]any)
+	case FidTypeRecordList:
+		_, ok = v.([]map[string]any)
+	default:
+		return fmt.Errorf("fid: fid %d is not a scalar type", f)
+	}
+	if !ok {
+		return fmt.Errorf("fid: fid %d expects %T-compatible value, got %T", f, zeroOf(t), v)
+	}
+	return nil
+}
+
+func validateRepeated(f Fid, spec FidSpec, v any) error {
+	switch spec.Type {
+	case FidTypeBool:
+		_, ok := v.([]bool)
+		if !ok {
+			return fmt.Errorf("fid: fid %d expects []bool, got %T", f, v)
+		}
+	case FidTypeI32:
+		_, ok := v.([]int32)
+		if !ok {
+			return fmt.Errorf("fid: fid %d expects []int32, got %T", f, v)
+		}
+	case FidTypeI64:
+		_, ok := v.([]int64)
+		if !ok {
+			return fmt.Errorf("fid: fid %d expects []int64, got %T", f, v)
+		}
+	case FidTypeU32:
+		_, ok := v.([]uint32)
+		if !ok {
+			return fmt.Errorf("fid: fid %d expects []uint32, got %T", f, v)
+		}
+	case FidTypeU64:
+		_, ok := v.([]uint64)
+		if !ok {
+			return fmt.Errorf("fid: fid %d expects []uint64, got %T", f, v)
+		}
+	case FidTypeF32:
+		_, ok := v.([]float32)
+		if !ok {
+			return fmt.Errorf("fid: fid %d expects []float32, got %T", f, v)
+		}
+	case FidTypeF64:
+		_, ok := v.([]float64)
+		if !ok {
+			return fmt.Errorf("fid: fid %d expects []float64, got %T", f, v)
+		}
+	case FidTypeString:
+		_, ok := v.([]string)
+		if !ok {
+			return fmt.Errorf("fid: fid %d expects []string, got %T", f, v)
+		}
+	case FidTypeRecord, FidTypeRecordList:
+		_, ok := v.([]map[string]any)
+		if !ok {
+			return fmt.Errorf("fid: fid %d expects []map[string]any, got %T", f, v)
+		}
+	default:
+		return fmt.Errorf("fid: fid %d has no repeated representation for type %v", f, spec.Type)
+	}
+	return nil
+}
+
+func zeroOf(t FidType) any {
+	switch t {
+	case FidTypeBool:
+		return false
+	case FidTypeI32:
+		return int32(0)
+	case FidTypeI64:
+		return int64(0)
+	case FidTypeU32:
+		return uint32(0)
+	case FidTypeU64:
+		return uint64(0)
+	case FidTypeF32:
+		return float32(0)
+	case FidTypeF64:
+		return float64(0)
+	case FidTypeString:
+		return ""
+	case FidTypeBytes:
+		return []byte(nil)
+	case FidTypeRecord:
+		return map[string]any(nil)
+	case FidTypeRecordList:
+		return []map[string]any(nil)
+	default:
+		return nil
+	}
+}
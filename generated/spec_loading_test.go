@@ -0,0 +1,41 @@
+package fid
+
+import (
+	"os"
+	"testing"
+)
+
+// TestNewRegistryFromYAMLLoadsSpecs guards against yamlFid silently
+// dropping the wire-type/bounds metadata (type/unit/min/max) that
+// registry/fids.yaml carries for every FID: a Registry loaded at runtime
+// should validate values exactly like the compiled-in Specs map does.
+func TestNewRegistryFromYAMLLoadsSpecs(t *testing.T) {
+	f, err := os.Open("../registry/fids.yaml")
+	if err != nil {
+		t.Fatalf("opening registry/fids.yaml: %v", err)
+	}
+	defer f.Close()
+
+	reg, err := NewRegistryFromYAML(f)
+	if err != nil {
+		t.Fatalf("NewRegistryFromYAML: %v", err)
+	}
+
+	spec, ok := reg.Spec(FidLatitude)
+	if !ok {
+		t.Fatal("Spec(FidLatitude) reported ok=false; type metadata was not loaded from yaml")
+	}
+	if spec.Type != FidTypeF64 || spec.Min == nil || *spec.Min != -90 {
+		t.Errorf("Spec(FidLatitude) = %+v, want Type=F64, Min=-90", spec)
+	}
+
+	if err := reg.Validate(FidLatitude, "not a float"); err == nil {
+		t.Error("Registry.Validate accepted a string for FidLatitude")
+	}
+	if err := reg.ValidateValue(FidLatitude, 91.0); err == nil {
+		t.Error("Registry.ValidateValue accepted an out-of-range latitude")
+	}
+	if err := reg.ValidateValue(FidLatitude, 45.0); err != nil {
+		t.Errorf("Registry.ValidateValue rejected a valid latitude: %v", err)
+	}
+}
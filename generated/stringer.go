@@ -0,0 +1,20 @@
+package fid
+
+import "fmt"
+
+// String returns the symbolic name of f (e.g. "ground_speed"), or
+// "Fid(NNN)" if f is not in FidNames. This is hand-written rather than
+// generated by stringer: stringer's default output names constants after
+// their Go identifier (FidGroundSpeed), and its -linecomment mode needs the
+// wire name as a same-line comment on each const, which fids.go doesn't
+// have (its doc comments sit on the line above and carry the FID number and
+// description, not just the wire name). Reshaping 196 generated constants
+// just to feed a generator isn't worth it, so String looks f up in
+// FidNames at run time instead, which keeps the two in sync without a
+// generator; TestFidNamesMatchString checks this for every known FID.
+func (f Fid) String() string {
+	if name, ok := FidNames[f]; ok {
+		return name
+	}
+	return fmt.Sprintf("Fid(%d)", uint16(f))
+}
@@ -0,0 +1,39 @@
+package fid
+
+import (
+	"os"
+	"regexp"
+	"testing"
+)
+
+var bannerVersionRe = regexp.MustCompile(`Auto-generated from registry/fids\.yaml v([0-9.]+)`)
+var schemaVersionRe = regexp.MustCompile(`schema_version:\s*"([0-9.]+)"`)
+
+// TestGeneratedBannerMatchesSchemaVersion guards against the generated-file
+// banner ("Auto-generated from registry/fids.yaml vX.Y.Z") drifting out of
+// sync with registry/fids.yaml's own schema_version, which happened across
+// a few commits before this test was added.
+func TestGeneratedBannerMatchesSchemaVersion(t *testing.T) {
+	fids, err := os.ReadFile("fids.go")
+	if err != nil {
+		t.Fatalf("reading fids.go: %v", err)
+	}
+	yaml, err := os.ReadFile("../registry/fids.yaml")
+	if err != nil {
+		t.Fatalf("reading registry/fids.yaml: %v", err)
+	}
+
+	bannerMatch := bannerVersionRe.FindSubmatch(fids)
+	if bannerMatch == nil {
+		t.Fatal("fids.go banner does not contain a recognizable version")
+	}
+	schemaMatch := schemaVersionRe.FindSubmatch(yaml)
+	if schemaMatch == nil {
+		t.Fatal("registry/fids.yaml does not contain a recognizable schema_version")
+	}
+
+	banner, schema := string(bannerMatch[1]), string(schemaMatch[1])
+	if banner != schema {
+		t.Errorf("fids.go banner says v%s but registry/fids.yaml schema_version is %q", banner, schema)
+	}
+}